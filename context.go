@@ -0,0 +1,59 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+import "context"
+
+// Translator is implemented by anything that can turn a rule's message tag
+// (e.g. "required", "email") into a localized message. It is the extension
+// point used by the translations subpackage, but callers can supply their
+// own implementation as well.
+type Translator interface {
+	T(tag string, params ...interface{}) string
+}
+
+type contextKey int
+
+const translatorKey contextKey = iota
+
+// ValidationOpts carries per-call state that should be available to rules
+// while a single top-level Validate/ValidateStruct call is in progress.
+type ValidationOpts struct {
+	Translator Translator
+}
+
+// WithContext attaches opts to ctx so it can later be recovered with
+// OptsFromContext. Pass the returned context to a context-aware rule's
+// ValidateWithContext method.
+func WithContext(ctx context.Context, opts ValidationOpts) context.Context {
+	return context.WithValue(ctx, translatorKey, opts)
+}
+
+// OptsFromContext recovers the ValidationOpts previously attached with
+// WithContext. ok is false if ctx carries none, in which case callers should
+// fall back to the library's untranslated default messages.
+func OptsFromContext(ctx context.Context) (opts ValidationOpts, ok bool) {
+	opts, ok = ctx.Value(translatorKey).(ValidationOpts)
+	return
+}
+
+// translate returns tag translated via the Translator carried by ctx, or
+// fallback if ctx carries no translator, or the translator doesn't recognize
+// tag (it returns ""). It is the helper rules use so that registering a
+// Translator is opt-in and current behavior is preserved when none is set or
+// a tag has no catalog entry.
+func translate(ctx context.Context, tag string, fallback string, params ...interface{}) string {
+	if ctx == nil {
+		return fallback
+	}
+	opts, ok := OptsFromContext(ctx)
+	if !ok || opts.Translator == nil {
+		return fallback
+	}
+	if translated := opts.Translator.T(tag, params...); translated != "" {
+		return translated
+	}
+	return fallback
+}
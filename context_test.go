@@ -0,0 +1,62 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aaerofeev/ozzo-validation/translations"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubTranslator struct {
+	translated string
+}
+
+func (s stubTranslator) T(tag string, params ...interface{}) string {
+	return s.translated
+}
+
+func TestWithContext(t *testing.T) {
+	ctx := WithContext(context.Background(), ValidationOpts{Translator: stubTranslator{"переведено"}})
+	opts, ok := OptsFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "переведено", opts.Translator.T("required"))
+
+	_, ok = OptsFromContext(context.Background())
+	assert.False(t, ok)
+}
+
+func Test_translate(t *testing.T) {
+	assert.Equal(t, "cannot be blank", translate(nil, "required", "cannot be blank"))
+	assert.Equal(t, "cannot be blank", translate(context.Background(), "required", "cannot be blank"))
+
+	ctx := WithContext(context.Background(), ValidationOpts{Translator: stubTranslator{"переведено"}})
+	assert.Equal(t, "переведено", translate(ctx, "required", "cannot be blank"))
+}
+
+// TestValidateWithContext_Translated exercises the full path a caller would
+// actually use: attaching a real locale Catalog via WithContext and reading
+// the translated message back out of Validate/ValidateStruct, not just the
+// translate() helper in isolation.
+func TestValidateWithContext_Translated(t *testing.T) {
+	ctx := WithContext(context.Background(), ValidationOpts{Translator: translations.RU})
+
+	err := ValidateWithContext(ctx, "", Required)
+	assert.Equal(t, "не может быть пустым", err.Error())
+
+	type form struct {
+		Name string
+	}
+	f := form{}
+	err = ValidateStructWithContext(ctx, &f, Field(&f.Name, Required))
+	errs, ok := err.(Errors)
+	assert.True(t, ok)
+	assert.Equal(t, "не может быть пустым", errs["Name"].Error())
+
+	// Without a translator attached, the untranslated default is unchanged.
+	assert.Equal(t, "required", Validate("", Required).Error())
+}
@@ -0,0 +1,78 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"reflect"
+)
+
+// ErrCycle is reserved for reporting a cycle in the struct graph being
+// validated, but the library currently treats a revisit as a no-op pass
+// instead: ValidateStruct's recursive walk stops descending into a pointer,
+// slice or map it has already seen earlier in the same top-level
+// Validate/ValidateStruct call, and simply returns nil for it, so a
+// self-referential graph such as a tree node pointing back at an ancestor
+// terminates without ever producing this error.
+var ErrCycle = errors.New("validation: cycle detected")
+
+type visitedKey struct{}
+
+// withVisited attaches a fresh visited set to ctx for the duration of one
+// top-level Validate/ValidateStruct call. The set is stored in the context
+// value rather than a package-global so that concurrent validations never
+// share state.
+func withVisited(ctx context.Context) context.Context {
+	return context.WithValue(ctx, visitedKey{}, make(map[uintptr]struct{}))
+}
+
+// visit records that the addressable pointer, slice or map header behind v
+// has been walked. It reports whether v was already visited, in which case
+// the caller should stop recursing into it. Values that are not addressable
+// as a pointer (e.g. plain structs passed by value) are never considered
+// visited, since they cannot participate in a reference cycle.
+func visit(ctx context.Context, v reflect.Value) (alreadyVisited bool) {
+	ptr, ok := pointerOf(v)
+	if !ok {
+		return false
+	}
+
+	visited, _ := ctx.Value(visitedKey{}).(map[uintptr]struct{})
+	if visited == nil {
+		return false
+	}
+	if _, seen := visited[ptr]; seen {
+		return true
+	}
+	visited[ptr] = struct{}{}
+	return false
+}
+
+// visitedFromContext reports whether ctx already carries a visited set, i.e.
+// whether it was produced by withVisited earlier in the current call. Validate
+// and ValidateStruct use it to attach a fresh set only at the top of the
+// recursive walk, so a nested call made with the same ctx keeps accumulating
+// into the same set instead of resetting it.
+func visitedFromContext(ctx context.Context) (map[uintptr]struct{}, bool) {
+	visited, ok := ctx.Value(visitedKey{}).(map[uintptr]struct{})
+	return visited, ok
+}
+
+// pointerOf extracts the address ValidateStruct's recursive walk should key
+// cycle detection on: the pointer itself for Ptr, and the backing array/data
+// pointer for Slice and Map, which is what aliases when a cyclic structure
+// is built out of them.
+func pointerOf(v reflect.Value) (uintptr, bool) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map:
+		if v.IsNil() {
+			return 0, false
+		}
+		return v.Pointer(), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type listNode struct {
+	Value int
+	Next  *listNode
+}
+
+// ValidateWithContext implements ValidatableWithContext, threading the live
+// context (and its cycle-detection state) down into the nested field.
+// Without cycle detection, a self-referential list would make this recurse
+// forever through ValidateWithContext -> ValidateStructWithContext -> Field(&n.Next) -> ValidateWithContext -> ...
+func (n *listNode) ValidateWithContext(ctx context.Context) error {
+	return ValidateStructWithContext(ctx, n, Field(&n.Next))
+}
+
+func TestCycle_LinkedList(t *testing.T) {
+	head := &listNode{Value: 1}
+	mid := &listNode{Value: 2}
+	head.Next = mid
+	mid.Next = head // cycle back to head
+
+	done := make(chan error, 1)
+	go func() { done <- Validate(head) }()
+
+	select {
+	case err := <-done:
+		assert.Nil(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("Validate did not return; a cyclic struct graph caused infinite recursion")
+	}
+}
+
+type selfMap struct {
+	Data map[string]interface{}
+}
+
+func TestCycle_SelfReferentialMap(t *testing.T) {
+	s := &selfMap{Data: map[string]interface{}{}}
+	s.Data["self"] = s.Data // the map contains itself
+
+	done := make(chan error, 1)
+	go func() { done <- ValidateStruct(s, Field(&s.Data)) }()
+
+	select {
+	case err := <-done:
+		assert.Nil(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("ValidateStruct did not return; a self-referential map caused infinite recursion")
+	}
+}
+
+func TestCycle_IsolatedBetweenCalls(t *testing.T) {
+	node := &listNode{Value: 1}
+
+	ctx1 := withVisited(context.Background())
+	assert.False(t, visit(ctx1, reflect.ValueOf(node)))
+
+	ctx2 := withVisited(context.Background())
+	assert.False(t, visit(ctx2, reflect.ValueOf(node)), "a fresh top-level call must not inherit the previous call's visited set")
+}
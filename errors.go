@@ -0,0 +1,40 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"sort"
+	"strings"
+)
+
+// Errors is the collection of validation errors returned by ValidateStruct,
+// keyed by the name of the field each error belongs to.
+type Errors map[string]error
+
+// Error implements the error interface, joining every field's message in a
+// stable, field-name-sorted order so the output is deterministic.
+func (es Errors) Error() string {
+	if len(es) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(es))
+	for key := range es {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for i, key := range keys {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+		b.WriteString(key)
+		b.WriteString(": ")
+		b.WriteString(es[key].Error())
+	}
+	b.WriteString(".")
+	return b.String()
+}
@@ -0,0 +1,321 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// FieldAwareRule is implemented by rules that need to look at another field
+// of the struct being validated, such as EqField or RequiredIf. ValidateStruct
+// calls ValidateField instead of Validate for rules that implement this
+// interface, passing it the ctx carrying the active Translator (so field
+// errors translate the same way any other rule's do) and the reflect.Value
+// of the struct itself so the named sibling field can be resolved.
+type FieldAwareRule interface {
+	Rule
+	ValidateField(ctx context.Context, value interface{}, parent reflect.Value) error
+}
+
+type fieldCompareRule struct {
+	field   string
+	tag     string
+	message string
+	compare func(a, b interface{}) bool
+}
+
+// EqField validates that the value equals the value of the named sibling
+// field.
+func EqField(field string) fieldCompareRule {
+	return fieldCompareRule{field: field, tag: "eq_field", message: "must equal " + field, compare: valuesEqual}
+}
+
+// NeField validates that the value does not equal the value of the named
+// sibling field.
+func NeField(field string) fieldCompareRule {
+	return fieldCompareRule{field: field, tag: "ne_field", message: "must not equal " + field, compare: func(a, b interface{}) bool {
+		return !valuesEqual(a, b)
+	}}
+}
+
+// GtField validates that the value is greater than the value of the named
+// sibling field. It supports numeric kinds, strings (lexical order) and
+// time.Time.
+func GtField(field string) fieldCompareRule {
+	return fieldCompareRule{field: field, tag: "gt_field", message: "must be greater than " + field, compare: func(a, b interface{}) bool {
+		result, ok := compareValues(a, b)
+		return ok && result > 0
+	}}
+}
+
+// GteField validates that the value is greater than or equal to the value
+// of the named sibling field.
+func GteField(field string) fieldCompareRule {
+	return fieldCompareRule{field: field, tag: "gte_field", message: "must be greater than or equal to " + field, compare: func(a, b interface{}) bool {
+		result, ok := compareValues(a, b)
+		return ok && result >= 0
+	}}
+}
+
+// LtField validates that the value is less than the value of the named
+// sibling field.
+func LtField(field string) fieldCompareRule {
+	return fieldCompareRule{field: field, tag: "lt_field", message: "must be less than " + field, compare: func(a, b interface{}) bool {
+		result, ok := compareValues(a, b)
+		return ok && result < 0
+	}}
+}
+
+// LteField validates that the value is less than or equal to the value of
+// the named sibling field.
+func LteField(field string) fieldCompareRule {
+	return fieldCompareRule{field: field, tag: "lte_field", message: "must be less than or equal to " + field, compare: func(a, b interface{}) bool {
+		result, ok := compareValues(a, b)
+		return ok && result <= 0
+	}}
+}
+
+// Error sets the error message for the rule. It also clears the rule's
+// catalog tag, since a caller-supplied message should never be silently
+// swapped out for a catalog translation of the original tag.
+func (r fieldCompareRule) Error(message string) fieldCompareRule {
+	r.tag = ""
+	r.message = message
+	return r
+}
+
+// Validate allows fieldCompareRule to satisfy Rule on its own, for callers
+// that invoke it directly outside of ValidateStruct. It always passes,
+// since there is no sibling field to compare against without a parent
+// struct; ValidateStruct calls ValidateField instead.
+func (r fieldCompareRule) Validate(value interface{}) error {
+	return nil
+}
+
+// ValidateField implements FieldAwareRule.
+func (r fieldCompareRule) ValidateField(ctx context.Context, value interface{}, parent reflect.Value) error {
+	value = indirect(value)
+	if IsEmpty(value) {
+		return nil
+	}
+
+	other, err := fieldValue(parent, r.field)
+	if err != nil {
+		return err
+	}
+	if IsEmpty(other) {
+		return nil
+	}
+
+	if !r.compare(value, other) {
+		return errors.New(translate(ctx, r.tag, r.message, r.field))
+	}
+	return nil
+}
+
+type requiredFieldRule struct {
+	field   string
+	value   interface{}
+	with    []string
+	without []string
+	unless  bool
+	tag     string
+	message string
+}
+
+// RequiredIf validates that the value is not empty, but only when the named
+// sibling field equals the given value. When the condition does not hold,
+// the field is allowed to be empty.
+func RequiredIf(field string, value interface{}) requiredFieldRule {
+	return requiredFieldRule{field: field, value: value, tag: "required", message: "cannot be blank"}
+}
+
+// RequiredUnless validates that the value is not empty, unless the named
+// sibling field equals the given value.
+func RequiredUnless(field string, value interface{}) requiredFieldRule {
+	return requiredFieldRule{field: field, value: value, unless: true, tag: "required", message: "cannot be blank"}
+}
+
+// RequiredWith validates that the value is not empty when any of the named
+// sibling fields is not empty.
+func RequiredWith(fields ...string) requiredFieldRule {
+	return requiredFieldRule{with: fields, tag: "required", message: "cannot be blank"}
+}
+
+// RequiredWithout validates that the value is not empty when any of the
+// named sibling fields is empty.
+func RequiredWithout(fields ...string) requiredFieldRule {
+	return requiredFieldRule{without: fields, tag: "required", message: "cannot be blank"}
+}
+
+// Error sets the error message for the rule. It also clears the rule's
+// catalog tag, since a caller-supplied message should never be silently
+// swapped out for a catalog translation of the original tag.
+func (r requiredFieldRule) Error(message string) requiredFieldRule {
+	r.tag = ""
+	r.message = message
+	return r
+}
+
+// Validate lets requiredFieldRule satisfy Rule on its own; like
+// fieldCompareRule it only does real work through ValidateField.
+func (r requiredFieldRule) Validate(value interface{}) error {
+	return nil
+}
+
+// ValidateField implements FieldAwareRule.
+func (r requiredFieldRule) ValidateField(ctx context.Context, value interface{}, parent reflect.Value) error {
+	if !r.required(parent) {
+		return nil
+	}
+	if IsEmpty(indirect(value)) {
+		return errors.New(translate(ctx, r.tag, r.message))
+	}
+	return nil
+}
+
+func (r requiredFieldRule) required(parent reflect.Value) bool {
+	switch {
+	case r.field != "":
+		other, err := fieldValue(parent, r.field)
+		if err != nil {
+			return false
+		}
+		matches := valuesEqual(other, r.value)
+		if r.unless {
+			return !matches
+		}
+		return matches
+	case len(r.with) > 0:
+		for _, f := range r.with {
+			if v, err := fieldValue(parent, f); err == nil && !IsEmpty(indirect(v)) {
+				return true
+			}
+		}
+		return false
+	case len(r.without) > 0:
+		for _, f := range r.without {
+			if v, err := fieldValue(parent, f); err == nil && IsEmpty(indirect(v)) {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
+// fieldValue resolves the named field of the struct held by parent,
+// dereferencing pointers the same way Required and NilOrNotEmpty do.
+func fieldValue(parent reflect.Value, name string) (interface{}, error) {
+	v := parent
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, fmt.Errorf("cannot resolve field %q: parent is nil", name)
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot resolve field %q: parent is not a struct", name)
+	}
+	f := v.FieldByName(name)
+	if !f.IsValid() {
+		return nil, fmt.Errorf("field %q does not exist", name)
+	}
+	return f.Interface(), nil
+}
+
+func valuesEqual(a, b interface{}) bool {
+	a, b = indirect(a), indirect(b)
+	return reflect.DeepEqual(a, b)
+}
+
+// compareValues orders a relative to b for numeric kinds, strings and
+// time.Time, returning a negative, zero, or positive int. ok is false when a
+// and b are not both one of these kinds, or are of kinds that can't be
+// compared with each other (e.g. an int field against a string field);
+// callers must treat that as a validation failure rather than assume equality.
+func compareValues(a, b interface{}) (result int, ok bool) {
+	a, b = indirect(a), indirect(b)
+
+	if at, isTime := a.(time.Time); isTime {
+		bt, isTime := b.(time.Time)
+		if !isTime {
+			return 0, false
+		}
+		switch {
+		case at.Before(bt):
+			return -1, true
+		case at.After(bt):
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !av.IsValid() || !bv.IsValid() || av.Kind() != bv.Kind() {
+		return 0, false
+	}
+
+	switch av.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch {
+		case av.Int() < bv.Int():
+			return -1, true
+		case av.Int() > bv.Int():
+			return 1, true
+		default:
+			return 0, true
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		switch {
+		case av.Uint() < bv.Uint():
+			return -1, true
+		case av.Uint() > bv.Uint():
+			return 1, true
+		default:
+			return 0, true
+		}
+	case reflect.Float32, reflect.Float64:
+		switch {
+		case av.Float() < bv.Float():
+			return -1, true
+		case av.Float() > bv.Float():
+			return 1, true
+		default:
+			return 0, true
+		}
+	case reflect.String:
+		switch {
+		case av.String() < bv.String():
+			return -1, true
+		case av.String() > bv.String():
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+	return 0, false
+}
+
+// indirect dereferences a pointer value, matching the nil/pointer handling
+// already used by Required and NilOrNotEmpty.
+func indirect(value interface{}) interface{} {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Interface()
+}
@@ -0,0 +1,272 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type passwordForm struct {
+	Password string
+	Confirm  string
+}
+
+func TestEqField(t *testing.T) {
+	tests := []struct {
+		tag  string
+		form passwordForm
+		err  string
+	}{
+		{"t1", passwordForm{"secret", "secret"}, ""},
+		{"t2", passwordForm{"secret", "other"}, "must equal Password"},
+		{"t3", passwordForm{"secret", ""}, ""},
+	}
+
+	r := EqField("Password")
+	for _, test := range tests {
+		err := r.ValidateField(context.Background(), test.form.Confirm, reflect.ValueOf(test.form))
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestNeField(t *testing.T) {
+	tests := []struct {
+		tag  string
+		form passwordForm
+		err  string
+	}{
+		{"t1", passwordForm{"secret", "other"}, ""},
+		{"t2", passwordForm{"secret", "secret"}, "must not equal Password"},
+		{"t3", passwordForm{"secret", ""}, ""},
+	}
+
+	r := NeField("Password")
+	for _, test := range tests {
+		err := r.ValidateField(context.Background(), test.form.Confirm, reflect.ValueOf(test.form))
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+type dateRange struct {
+	Start string
+	End   string
+}
+
+func TestGtField(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value dateRange
+		err   string
+	}{
+		{"t1", dateRange{"a", "b"}, ""},
+		{"t2", dateRange{"b", "a"}, "must be greater than Start"},
+		{"t3", dateRange{"a", ""}, ""},
+	}
+
+	r := GtField("Start")
+	for _, test := range tests {
+		err := r.ValidateField(context.Background(), test.value.End, reflect.ValueOf(test.value))
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestGteField(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value dateRange
+		err   string
+	}{
+		{"t1", dateRange{"a", "b"}, ""},
+		{"t2", dateRange{"a", "a"}, ""},
+		{"t3", dateRange{"b", "a"}, "must be greater than or equal to Start"},
+		{"t4", dateRange{"a", ""}, ""},
+	}
+
+	r := GteField("Start")
+	for _, test := range tests {
+		err := r.ValidateField(context.Background(), test.value.End, reflect.ValueOf(test.value))
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestLtField(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value dateRange
+		err   string
+	}{
+		{"t1", dateRange{"b", "a"}, ""},
+		{"t2", dateRange{"a", "b"}, "must be less than Start"},
+		{"t3", dateRange{"a", ""}, ""},
+	}
+
+	r := LtField("Start")
+	for _, test := range tests {
+		err := r.ValidateField(context.Background(), test.value.End, reflect.ValueOf(test.value))
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestLteField(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value dateRange
+		err   string
+	}{
+		{"t1", dateRange{"b", "a"}, ""},
+		{"t2", dateRange{"a", "a"}, ""},
+		{"t3", dateRange{"a", "b"}, "must be less than or equal to Start"},
+		{"t4", dateRange{"a", ""}, ""},
+	}
+
+	r := LteField("Start")
+	for _, test := range tests {
+		err := r.ValidateField(context.Background(), test.value.End, reflect.ValueOf(test.value))
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+type conditionalForm struct {
+	Type     string
+	PlanCode string
+}
+
+func TestRequiredIf(t *testing.T) {
+	tests := []struct {
+		tag  string
+		form conditionalForm
+		err  string
+	}{
+		{"t1", conditionalForm{"premium", "gold"}, ""},
+		{"t2", conditionalForm{"premium", ""}, "cannot be blank"},
+		{"t3", conditionalForm{"basic", ""}, ""},
+	}
+
+	r := RequiredIf("Type", "premium")
+	for _, test := range tests {
+		err := r.ValidateField(context.Background(), test.form.PlanCode, reflect.ValueOf(test.form))
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestRequiredUnless(t *testing.T) {
+	tests := []struct {
+		tag  string
+		form conditionalForm
+		err  string
+	}{
+		{"t1", conditionalForm{"basic", ""}, "cannot be blank"},
+		{"t2", conditionalForm{"basic", "std"}, ""},
+		{"t3", conditionalForm{"premium", ""}, ""},
+	}
+
+	r := RequiredUnless("Type", "premium")
+	for _, test := range tests {
+		err := r.ValidateField(context.Background(), test.form.PlanCode, reflect.ValueOf(test.form))
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+type withForm struct {
+	Phone string
+	Email string
+}
+
+func TestRequiredWith(t *testing.T) {
+	tests := []struct {
+		tag  string
+		form withForm
+		err  string
+	}{
+		{"t1", withForm{"123", "a@b.com"}, ""},
+		{"t2", withForm{"123", ""}, "cannot be blank"},
+		{"t3", withForm{"", ""}, ""},
+	}
+
+	r := RequiredWith("Phone")
+	for _, test := range tests {
+		err := r.ValidateField(context.Background(), test.form.Email, reflect.ValueOf(test.form))
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestRequiredWithout(t *testing.T) {
+	tests := []struct {
+		tag  string
+		form withForm
+		err  string
+	}{
+		{"t1", withForm{"", "a@b.com"}, ""},
+		{"t2", withForm{"", ""}, "cannot be blank"},
+		{"t3", withForm{"123", ""}, ""},
+	}
+
+	r := RequiredWithout("Phone")
+	for _, test := range tests {
+		err := r.ValidateField(context.Background(), test.form.Email, reflect.ValueOf(test.form))
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+// TestEqField_ViaValidateStruct exercises EqField the way the request's own
+// example does: as a rule passed to ValidateStruct, not by calling
+// ValidateField directly.
+func TestEqField_ViaValidateStruct(t *testing.T) {
+	form := passwordForm{Password: "secret", Confirm: "other"}
+	err := ValidateStruct(&form, Field(&form.Confirm, EqField("Password")))
+	assert.NotNil(t, err)
+
+	errs, ok := err.(Errors)
+	assert.True(t, ok)
+	assert.Equal(t, "must equal Password", errs["Confirm"].Error())
+
+	form.Confirm = "secret"
+	err = ValidateStruct(&form, Field(&form.Confirm, EqField("Password")))
+	assert.Nil(t, err)
+}
+
+func TestRequiredIf_ViaValidateStruct(t *testing.T) {
+	form := conditionalForm{Type: "premium", PlanCode: ""}
+	err := ValidateStruct(&form, Field(&form.PlanCode, RequiredIf("Type", "premium")))
+	assert.NotNil(t, err)
+
+	form.PlanCode = "gold"
+	err = ValidateStruct(&form, Field(&form.PlanCode, RequiredIf("Type", "premium")))
+	assert.Nil(t, err)
+}
+
+type mixedKindsForm struct {
+	Count int
+	Label string
+}
+
+func TestGtField_MismatchedKinds(t *testing.T) {
+	form := mixedKindsForm{Count: 5, Label: "abc"}
+	r := GtField("Count")
+
+	assert.NotPanics(t, func() {
+		err := r.ValidateField(context.Background(), form.Label, reflect.ValueOf(form))
+		assert.NotNil(t, err, "comparing a string field against an int field must fail validation, not panic")
+	})
+}
+
+type int64Range struct {
+	Start int64
+	End   int64
+}
+
+func TestGtField_LargeInt64(t *testing.T) {
+	// End - Start would overflow int64 and flip sign if compareValues
+	// subtracted instead of comparing directly.
+	form := int64Range{Start: math.MinInt64, End: math.MaxInt64}
+	r := GtField("Start")
+	err := r.ValidateField(context.Background(), form.End, reflect.ValueOf(form))
+	assert.Nil(t, err, "MaxInt64 is greater than MinInt64")
+}
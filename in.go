@@ -0,0 +1,53 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// InRule is a validation rule that validates if a value can be found in the
+// given list of values.
+type InRule struct {
+	elems   []interface{}
+	message string
+}
+
+// In returns a validation rule that checks if a value can be found in the
+// given list of values. reflect.DeepEqual is used to determine if two values
+// are equal. An empty value is always treated as valid; use the Required rule
+// to make sure a value is not empty.
+func In(values ...interface{}) *InRule {
+	return &InRule{elems: values, message: "in"}
+}
+
+// Validate checks if the given value is valid or not.
+func (r *InRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+// ValidateWithContext is like Validate, but translates the error message via
+// the Translator carried by ctx, if any.
+func (r *InRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	for _, e := range r.elems {
+		if reflect.DeepEqual(e, value) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s|%v", translate(ctx, r.message, r.message), r.elems)
+}
+
+// Error sets the error message for the rule.
+func (r *InRule) Error(message string) *InRule {
+	r.message = message
+	return r
+}
@@ -0,0 +1,290 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/aaerofeev/ozzo-validation"
+	"github.com/aaerofeev/ozzo-validation/translations"
+)
+
+var (
+	// E164 validates if a string is a valid phone number in E.164 format.
+	E164 = validation.NewStringRule(isE164, "e164")
+	// IBAN validates if a string is a valid International Bank Account Number.
+	IBAN = validation.NewStringRule(isIBAN, "iban")
+	// BIC validates if a string is a valid ISO 9362 Business Identifier Code.
+	BIC = validation.NewStringRule(isBIC, "bic")
+	// JWT validates if a string is a well-formed JSON Web Token.
+	JWT = validation.NewStringRule(isJWT, "jwt")
+	// BitcoinAddress validates if a string is a valid Bitcoin address, either
+	// Base58Check (P2PKH/P2SH) or Bech32 (segwit).
+	BitcoinAddress = validation.NewStringRule(isBitcoinAddress, "btc_address")
+)
+
+// init registers this file's message tags with the shipped locale catalogs,
+// the same way a project would register its own NewStringRule-based rules.
+func init() {
+	translations.Register("e164", map[*translations.Catalog]string{
+		translations.EN: "must be a valid E.164 phone number",
+		translations.RU: "должен быть действительным номером телефона в формате E.164",
+		translations.FR: "doit être un numéro de téléphone E.164 valide",
+	})
+	translations.Register("iban", map[*translations.Catalog]string{
+		translations.EN: "must be a valid IBAN",
+		translations.RU: "должен быть действительным IBAN",
+		translations.FR: "doit être un IBAN valide",
+	})
+	translations.Register("bic", map[*translations.Catalog]string{
+		translations.EN: "must be a valid BIC",
+		translations.RU: "должен быть действительным BIC",
+		translations.FR: "doit être un BIC valide",
+	})
+	translations.Register("jwt", map[*translations.Catalog]string{
+		translations.EN: "must be a valid JSON Web Token",
+		translations.RU: "должен быть действительным JSON Web Token",
+		translations.FR: "doit être un JSON Web Token valide",
+	})
+	translations.Register("btc_address", map[*translations.Catalog]string{
+		translations.EN: "must be a valid Bitcoin address",
+		translations.RU: "должен быть действительным биткоин-адресом",
+		translations.FR: "doit être une adresse Bitcoin valide",
+	})
+}
+
+var (
+	reE164 = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+	reBIC  = regexp.MustCompile(`^[A-Z]{6}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+
+	ibanCountryLength = map[string]int{
+		"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16,
+		"BG": 22, "BH": 22, "BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28,
+		"CZ": 24, "DE": 22, "DK": 18, "DO": 28, "EE": 20, "EG": 29, "ES": 24,
+		"FI": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18,
+		"GR": 27, "GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IS": 26,
+		"IT": 27, "JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21,
+		"LT": 20, "LU": 20, "LV": 21, "MC": 27, "MD": 24, "ME": 22, "MK": 19,
+		"MR": 27, "MT": 31, "MU": 30, "NL": 18, "NO": 15, "PK": 24, "PL": 28,
+		"PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "SA": 24, "SC": 31,
+		"SE": 24, "SI": 19, "SK": 24, "SM": 27, "TL": 23, "TN": 24, "TR": 26,
+		"UA": 29, "VA": 22, "VG": 24, "XK": 20,
+	}
+
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	bech32Charset  = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+)
+
+func isE164(value string) bool {
+	return reE164.MatchString(value)
+}
+
+func isBIC(value string) bool {
+	return reBIC.MatchString(value)
+}
+
+// isIBAN validates the structure and the mod-97 checksum described by
+// ISO 13616: move the first 4 characters to the end, map letters to
+// numbers (A=10, ..., Z=35), and verify the resulting number mod 97 == 1.
+func isIBAN(value string) bool {
+	value = strings.ToUpper(strings.ReplaceAll(value, " ", ""))
+	if len(value) < 4 {
+		return false
+	}
+
+	wantLen, ok := ibanCountryLength[value[:2]]
+	if !ok || len(value) != wantLen {
+		return false
+	}
+
+	rearranged := value[4:] + value[:4]
+
+	var numeric strings.Builder
+	for _, c := range rearranged {
+		switch {
+		case c >= '0' && c <= '9':
+			numeric.WriteRune(c)
+		case c >= 'A' && c <= 'Z':
+			numeric.WriteString(itoa(int(c-'A') + 10))
+		default:
+			return false
+		}
+	}
+
+	return mod97(numeric.String()) == 1
+}
+
+// mod97 computes the remainder of the decimal digit string s modulo 97,
+// folding in one digit at a time so it never has to materialize the full
+// (possibly huge) integer.
+func mod97(s string) int {
+	remainder := 0
+	for _, c := range s {
+		remainder = (remainder*10 + int(c-'0')) % 97
+	}
+	return remainder
+}
+
+func itoa(n int) string {
+	if n < 10 {
+		return string(rune('0' + n))
+	}
+	return string(rune('0'+n/10)) + string(rune('0'+n%10))
+}
+
+// isJWT validates that value has the three base64url segments of a JSON
+// Web Token, and that the header and payload segments decode to valid JSON.
+func isJWT(value string) bool {
+	parts := strings.Split(value, ".")
+	if len(parts) != 3 {
+		return false
+	}
+
+	for _, part := range parts[:2] {
+		decoded, err := base64.RawURLEncoding.DecodeString(part)
+		if err != nil {
+			return false
+		}
+		if !json.Valid(decoded) {
+			return false
+		}
+	}
+
+	if _, err := base64.RawURLEncoding.DecodeString(parts[2]); err != nil {
+		return false
+	}
+
+	return true
+}
+
+// isBitcoinAddress validates a Base58Check P2PKH/P2SH address (double
+// SHA-256 checksum) or a Bech32 segwit address per BIP-173.
+func isBitcoinAddress(value string) bool {
+	if strings.HasPrefix(value, "bc1") || strings.HasPrefix(value, "tb1") {
+		return isBech32BitcoinAddress(value)
+	}
+	return isBase58BitcoinAddress(value)
+}
+
+func isBase58BitcoinAddress(value string) bool {
+	if len(value) < 26 || len(value) > 35 {
+		return false
+	}
+	for _, c := range value {
+		if !strings.ContainsRune(base58Alphabet, c) {
+			return false
+		}
+	}
+
+	decoded := base58Decode(value)
+	if len(decoded) < 25 {
+		return false
+	}
+
+	payload, checksum := decoded[:len(decoded)-4], decoded[len(decoded)-4:]
+	sum1 := sha256.Sum256(payload)
+	sum2 := sha256.Sum256(sum1[:])
+	for i, b := range checksum {
+		if sum2[i] != b {
+			return false
+		}
+	}
+	return true
+}
+
+func base58Decode(s string) []byte {
+	result := []byte{0}
+	for _, c := range s {
+		index := strings.IndexRune(base58Alphabet, c)
+		carry := index
+		for i := 0; i < len(result); i++ {
+			carry += int(result[i]) * 58
+			result[i] = byte(carry & 0xff)
+			carry >>= 8
+		}
+		for carry > 0 {
+			result = append(result, byte(carry&0xff))
+			carry >>= 8
+		}
+	}
+	for _, c := range s {
+		if c != '1' {
+			break
+		}
+		result = append(result, 0)
+	}
+	// reverse to big-endian
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return result
+}
+
+// isBech32BitcoinAddress validates the human-readable-part, charset and
+// checksum of a BIP-173 Bech32 address with HRP "bc" (mainnet) or "tb"
+// (testnet).
+func isBech32BitcoinAddress(value string) bool {
+	lower := strings.ToLower(value)
+	if value != lower && value != strings.ToUpper(value) {
+		return false
+	}
+
+	sep := strings.LastIndex(lower, "1")
+	if sep < 1 || sep+7 > len(lower) {
+		return false
+	}
+
+	hrp, data := lower[:sep], lower[sep+1:]
+	if hrp != "bc" && hrp != "tb" {
+		return false
+	}
+
+	for _, c := range data {
+		if !strings.ContainsRune(bech32Charset, c) {
+			return false
+		}
+	}
+
+	return bech32VerifyChecksum(hrp, data)
+}
+
+func bech32VerifyChecksum(hrp, data string) bool {
+	values := make([]int, len(data))
+	for i, c := range data {
+		values[i] = strings.IndexRune(bech32Charset, c)
+	}
+	return bech32Polymod(append(bech32HRPExpand(hrp), values...)) == 1
+}
+
+func bech32HRPExpand(hrp string) []int {
+	result := make([]int, 0, len(hrp)*2+1)
+	for _, c := range hrp {
+		result = append(result, int(c)>>5)
+	}
+	result = append(result, 0)
+	for _, c := range hrp {
+		result = append(result, int(c)&31)
+	}
+	return result
+}
+
+func bech32Polymod(values []int) int {
+	generator := []int{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := 1
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ v
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
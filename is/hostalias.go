@@ -0,0 +1,189 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/asaskevich/govalidator"
+)
+
+// HostAlias validates a string shaped like "10.0.0.5:db.internal,db": an IP
+// (v4, or v6 wrapped in brackets as in "[::1]:db"; an unbracketed v6 address
+// is rejected) followed by ":" and one or more
+// comma-separated DNS names, each conforming to RFC 1123. It mirrors the
+// shape of the --host-alias flag popularized by container tooling.
+var HostAlias = hostAliasRule{}
+
+// HostAliases validates a slice of HostAlias-shaped entries.
+var HostAliases = hostAliasesRule{}
+
+// HostAliasError reports which alias entry failed to parse or validate, so
+// callers can surface an actionable message instead of a generic "invalid"
+// error.
+type HostAliasError struct {
+	Entry  string
+	Reason string
+}
+
+func (e *HostAliasError) Error() string {
+	return fmt.Sprintf("invalid host alias %q: %s", e.Entry, e.Reason)
+}
+
+var reRFC1123Label = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+type hostAliasRule struct {
+	message string
+}
+
+// Error sets the error message for the rule.
+func (r hostAliasRule) Error(message string) hostAliasRule {
+	r.message = message
+	return r
+}
+
+// Validate implements validation.Rule.
+func (r hostAliasRule) Validate(value interface{}) error {
+	s, isNil := stringFromValue(value)
+	if isNil || s == "" {
+		return nil
+	}
+	if err := validateHostAlias(s); err != nil {
+		if r.message != "" {
+			return errors.New(r.message)
+		}
+		return err
+	}
+	return nil
+}
+
+type hostAliasesRule struct {
+	message string
+}
+
+// Error sets the error message for the rule.
+func (r hostAliasesRule) Error(message string) hostAliasesRule {
+	r.message = message
+	return r
+}
+
+// Validate implements validation.Rule. It accepts either a []string or a
+// single comma-joined string of entries, e.g.
+// "10.0.0.5:db.internal,db,[::1]:redis".
+func (r hostAliasesRule) Validate(value interface{}) error {
+	var entries []string
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []string:
+		entries = v
+	case string:
+		if v == "" {
+			return nil
+		}
+		entries = splitHostAliasEntries(v)
+	default:
+		return errors.New("must be a []string or a string")
+	}
+
+	for _, entry := range entries {
+		if err := validateHostAlias(entry); err != nil {
+			if r.message != "" {
+				return errors.New(r.message)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// splitHostAliasEntries splits a comma-joined HostAliases string back into
+// individual "IP:host[,host,...]" entries. A comma is overloaded in this
+// format: it both joins entries and separates the hosts within one entry's
+// host list. A token is only treated as the start of a new entry if it
+// contains a ':' (the IP/host-list separator); otherwise it is an
+// additional host appended to the entry currently being built.
+func splitHostAliasEntries(s string) []string {
+	tokens := strings.Split(s, ",")
+	entries := make([]string, 0, len(tokens))
+	for _, tok := range tokens {
+		if len(entries) == 0 || strings.Contains(tok, ":") {
+			entries = append(entries, tok)
+			continue
+		}
+		entries[len(entries)-1] += "," + tok
+	}
+	return entries
+}
+
+// validateHostAlias parses and validates a single "IP:host[,host,...]" entry.
+func validateHostAlias(entry string) error {
+	sep := strings.Index(entry, "]:")
+	var ip, hosts string
+	if strings.HasPrefix(entry, "[") && sep >= 0 {
+		ip = entry[1:sep]
+		hosts = entry[sep+2:]
+	} else {
+		idx := strings.Index(entry, ":")
+		if idx < 0 {
+			return &HostAliasError{Entry: entry, Reason: "missing ':' between IP and host list"}
+		}
+		ip, hosts = entry[:idx], entry[idx+1:]
+	}
+
+	if !govalidator.IsIP(ip) {
+		return &HostAliasError{Entry: entry, Reason: fmt.Sprintf("%q is not a valid IP address", ip)}
+	}
+
+	if hosts == "" {
+		return &HostAliasError{Entry: entry, Reason: "no hosts given"}
+	}
+
+	for _, host := range strings.Split(hosts, ",") {
+		if err := validateRFC1123Hostname(host); err != nil {
+			return &HostAliasError{Entry: entry, Reason: err.Error()}
+		}
+	}
+	return nil
+}
+
+func validateRFC1123Hostname(host string) error {
+	if host == "" {
+		return fmt.Errorf("empty host name")
+	}
+	if len(host) > 253 {
+		return fmt.Errorf("%q is longer than 253 characters", host)
+	}
+	for _, label := range strings.Split(host, ".") {
+		if len(label) == 0 || len(label) > 63 {
+			return fmt.Errorf("label %q must be 1-63 characters", label)
+		}
+		if !reRFC1123Label.MatchString(label) {
+			return fmt.Errorf("label %q is not a valid RFC 1123 host label", label)
+		}
+	}
+	return nil
+}
+
+// stringFromValue dereferences *string the same way the rest of the is
+// package does, reporting isNil for untyped nil or a nil pointer.
+func stringFromValue(value interface{}) (s string, isNil bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", true
+	case string:
+		return v, false
+	case *string:
+		if v == nil {
+			return "", true
+		}
+		return *v, false
+	default:
+		return fmt.Sprintf("%v", value), false
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostAlias(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value string
+		valid bool
+	}{
+		{"single host", "10.0.0.5:db.internal", true},
+		{"multiple hosts", "10.0.0.5:db.internal,db", true},
+		{"ipv6 bracketed", "[::1]:db.internal", true},
+		{"missing colon", "10.0.0.5", false},
+		{"bad ip", "10.0.0.999:db", false},
+		{"bad label", "10.0.0.5:-bad", false},
+		{"empty hosts", "10.0.0.5:", false},
+	}
+
+	for _, test := range tests {
+		err := HostAlias.Validate(test.value)
+		if test.valid {
+			assert.Nil(t, err, test.tag)
+		} else {
+			assert.NotNil(t, err, test.tag)
+			assert.IsType(t, &HostAliasError{}, err, test.tag)
+		}
+	}
+
+	assert.Nil(t, HostAlias.Validate(""))
+	assert.Nil(t, HostAlias.Validate(nil))
+}
+
+func TestHostAliases(t *testing.T) {
+	assert.Nil(t, HostAliases.Validate([]string{"10.0.0.5:db.internal", "[::1]:redis"}))
+	assert.NotNil(t, HostAliases.Validate([]string{"10.0.0.5:db.internal", "not-an-entry"}))
+
+	// A comma both joins entries and separates the hosts within a single
+	// entry's host list: "db.internal,db" is one entry with two hosts, while
+	// the second comma below starts a new "[::1]:redis" entry.
+	assert.Nil(t, HostAliases.Validate("10.0.0.5:db.internal,db,[::1]:redis"))
+	assert.NotNil(t, HostAliases.Validate("10.0.0.5:db.internal,[::1]:-bad"))
+	assert.NotNil(t, HostAliases.Validate(123))
+}
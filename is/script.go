@@ -0,0 +1,76 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"unicode"
+
+	"github.com/aaerofeev/ozzo-validation"
+	"github.com/aaerofeev/ozzo-validation/translations"
+)
+
+// Presets for the scripts most commonly checked with AlphaIn/AlphanumericIn.
+var (
+	// CyrillicAlpha validates if a string contains Cyrillic letters only.
+	CyrillicAlpha = AlphaIn(unicode.Cyrillic)
+	// HanAlpha validates if a string contains Han (Chinese) letters only.
+	HanAlpha = AlphaIn(unicode.Han)
+	// LatinAlpha validates if a string contains Latin letters only.
+	LatinAlpha = AlphaIn(unicode.Latin)
+)
+
+type scriptRule struct {
+	scripts   []*unicode.RangeTable
+	numeric   bool
+	message   string
+}
+
+// AlphaIn validates that a string contains only letters from the given
+// Unicode scripts (e.g. unicode.Cyrillic, unicode.Han, unicode.Latin). An
+// empty string is valid, matching the existing Alpha/UTFLetter rules.
+func AlphaIn(scripts ...*unicode.RangeTable) validation.StringRule {
+	return validation.NewStringRule(newScriptRule(scripts, false).validate, "alpha_script")
+}
+
+// AlphanumericIn validates that a string contains only letters from the
+// given Unicode scripts and/or digits.
+func AlphanumericIn(scripts ...*unicode.RangeTable) validation.StringRule {
+	return validation.NewStringRule(newScriptRule(scripts, true).validate, "alphanumeric_script")
+}
+
+// init registers this file's message tags with the shipped locale catalogs,
+// the same way a project would register its own NewStringRule-based rules.
+func init() {
+	translations.Register("alpha_script", map[*translations.Catalog]string{
+		translations.EN: "must contain only letters from the allowed scripts",
+		translations.RU: "должно содержать только буквы из допустимых алфавитов",
+		translations.FR: "ne doit contenir que des lettres des alphabets autorisés",
+	})
+	translations.Register("alphanumeric_script", map[*translations.Catalog]string{
+		translations.EN: "must contain only letters from the allowed scripts and digits",
+		translations.RU: "должно содержать только буквы из допустимых алфавитов и цифры",
+		translations.FR: "ne doit contenir que des lettres des alphabets autorisés et des chiffres",
+	})
+}
+
+func newScriptRule(scripts []*unicode.RangeTable, numeric bool) scriptRule {
+	return scriptRule{scripts: scripts, numeric: numeric}
+}
+
+func (r scriptRule) validate(value string) bool {
+	if value == "" {
+		return true
+	}
+	for _, c := range value {
+		if unicode.In(c, r.scripts...) {
+			continue
+		}
+		if r.numeric && unicode.IsDigit(c) {
+			continue
+		}
+		return false
+	}
+	return true
+}
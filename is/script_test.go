@@ -0,0 +1,44 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlphaIn(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  interface{ Validate(interface{}) error }
+		value string
+		err   string
+	}{
+		{"cyrillic valid", CyrillicAlpha, "привет", ""},
+		{"cyrillic invalid latin", CyrillicAlpha, "привет world", "alpha_script"},
+		{"cyrillic invalid digit", CyrillicAlpha, "привет1", "alpha_script"},
+		{"han valid", HanAlpha, "你好", ""},
+		{"latin valid", LatinAlpha, "hello", ""},
+		{"empty string", CyrillicAlpha, "", ""},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestAlphanumericIn(t *testing.T) {
+	r := AlphanumericIn(unicode.Cyrillic)
+
+	assert.Nil(t, r.Validate(""))
+	assert.Nil(t, r.Validate("привет123"))
+	assert.NotNil(t, r.Validate("привет world"))
+
+	s := "привет"
+	assert.Nil(t, r.Validate(&s))
+}
@@ -0,0 +1,48 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"context"
+	"errors"
+)
+
+type requiredRule struct {
+	skipNil bool
+	message string
+}
+
+// Required is a validation rule that checks if a value is not empty.
+var Required = requiredRule{message: "required"}
+
+// NilOrNotEmpty is a validation rule that checks if a value is a nil pointer
+// or a non-empty value.
+var NilOrNotEmpty = requiredRule{skipNil: true, message: "required"}
+
+// Validate checks if the given value is valid or not.
+func (r requiredRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+// ValidateWithContext is like Validate, but translates the error message via
+// the Translator carried by ctx, if any.
+func (r requiredRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	value, isNil := Indirect(value)
+
+	if r.skipNil && !isNil && IsEmpty(value) {
+		return errors.New(translate(ctx, r.message, r.message))
+	}
+	if !r.skipNil && (isNil || IsEmpty(value)) {
+		return errors.New(translate(ctx, r.message, r.message))
+	}
+
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r requiredRule) Error(message string) requiredRule {
+	r.message = message
+	return r
+}
@@ -0,0 +1,55 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"context"
+	"errors"
+)
+
+// StringRule is a rule that checks a string value using a function.
+type StringRule struct {
+	rule    func(string) bool
+	message string
+}
+
+// NewStringRule creates a new validation rule using a function that takes a
+// string value and returns a bool indicating whether the validation succeeds.
+// An empty value is always treated as valid; use the Required rule to make
+// sure a value is not empty.
+func NewStringRule(rule func(string) bool, message string) StringRule {
+	return StringRule{rule: rule, message: message}
+}
+
+// Validate checks if the given value is valid or not.
+func (r StringRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+// ValidateWithContext is like Validate, but translates the error message via
+// the Translator carried by ctx, if any.
+func (r StringRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if r.rule(str) {
+		return nil
+	}
+
+	return errors.New(translate(ctx, r.message, r.message))
+}
+
+// Error sets the error message for the rule.
+func (r StringRule) Error(message string) StringRule {
+	r.message = message
+	return r
+}
@@ -0,0 +1,122 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"reflect"
+)
+
+// FieldRules represents a rule set associated with a struct field.
+type FieldRules struct {
+	fieldPtr interface{}
+	rules    []Rule
+}
+
+// Field specifies a struct field and the rules that apply to it. fieldPtr must
+// be a pointer to the field, e.g. Field(&a.Name, Required).
+func Field(fieldPtr interface{}, rules ...Rule) *FieldRules {
+	return &FieldRules{fieldPtr: fieldPtr, rules: rules}
+}
+
+// ValidateStruct validates a struct by checking the rules for each of its
+// fields that was registered with Field. The value of each registered field is
+// also walked recursively the same way Validate does, so a field whose own type
+// implements Validatable or ValidatableWithContext is validated too.
+func ValidateStruct(structPtr interface{}, fields ...*FieldRules) error {
+	return ValidateStructWithContext(context.Background(), structPtr, fields...)
+}
+
+// ValidateStructWithContext is like ValidateStruct, but lets the caller
+// attach a ValidationOpts (e.g. a Translator) via WithContext before calling it.
+func ValidateStructWithContext(ctx context.Context, structPtr interface{}, fields ...*FieldRules) error {
+	if _, ok := visitedFromContext(ctx); !ok {
+		ctx = withVisited(ctx)
+	}
+
+	value := reflect.ValueOf(structPtr)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return errors.New("validation: ValidateStruct requires a non-nil struct pointer")
+	}
+	// structPtr itself is not re-checked against the visited set here: when
+	// ValidateStructWithContext is reached through the automatic recursion in
+	// validateValue (the common case for a cyclic graph, via Validatable or
+	// ValidatableWithContext), that caller has already performed the check for
+	// this exact pointer. Re-checking it here would mark every struct as
+	// already visited on its very first, legitimate pass.
+	structValue := value.Elem()
+
+	errs := Errors{}
+	for _, fr := range fields {
+		fieldValue, name, err := fr.resolve(structValue)
+		if err != nil {
+			return err
+		}
+		if err := fr.validate(ctx, fieldValue, structValue); err != nil {
+			errs[name] = err
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// resolve finds the exported field of structValue whose address matches
+// fr.fieldPtr, returning its current value and name. It searches nested
+// (non-pointer) struct fields too, so a pointer to a field on an embedded or
+// nested struct, e.g. &c.Address.Street, resolves correctly.
+func (fr *FieldRules) resolve(structValue reflect.Value) (interface{}, string, error) {
+	if value, name, ok := findField(structValue, fr.fieldPtr); ok {
+		return value, name, nil
+	}
+	return nil, "", errors.New("validation: field not found for the given pointer; did you pass the address of a field on the struct being validated?")
+}
+
+func findField(structValue reflect.Value, fieldPtr interface{}) (interface{}, string, bool) {
+	t := structValue.Type()
+	for i := 0; i < structValue.NumField(); i++ {
+		field := structValue.Field(i)
+		if !field.CanAddr() {
+			continue
+		}
+		if field.Addr().Interface() == fieldPtr {
+			return field.Interface(), t.Field(i).Name, true
+		}
+		if field.Kind() == reflect.Struct {
+			if value, name, ok := findField(field, fieldPtr); ok {
+				return value, name, true
+			}
+		}
+	}
+	return nil, "", false
+}
+
+// validate runs fr.rules against fieldValue. Rules implementing FieldAwareRule
+// receive parent (the struct being validated) instead of going through
+// Validate, so they can resolve a sibling field, as EqField/RequiredIf and
+// friends do. Once the explicit rules pass, fieldValue is walked the same way
+// Validate walks any other value.
+func (fr *FieldRules) validate(ctx context.Context, fieldValue interface{}, parent reflect.Value) error {
+	for _, rule := range fr.rules {
+		if far, ok := rule.(FieldAwareRule); ok {
+			if err := far.ValidateField(ctx, fieldValue, parent); err != nil {
+				return err
+			}
+			continue
+		}
+		if cr, ok := rule.(RuleWithContext); ok {
+			if err := cr.ValidateWithContext(ctx, fieldValue); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := rule.Validate(fieldValue); err != nil {
+			return err
+		}
+	}
+	return validateValue(ctx, fieldValue)
+}
@@ -0,0 +1,44 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type address struct {
+	Street string
+	City   string
+}
+
+type customer struct {
+	Name    string
+	Address address
+}
+
+func TestValidateStruct(t *testing.T) {
+	c := customer{Name: "", Address: address{Street: "", City: "Metropolis"}}
+	err := ValidateStruct(&c,
+		Field(&c.Name, Required),
+		Field(&c.Address.Street, Required),
+	)
+	assert.NotNil(t, err)
+
+	errs, ok := err.(Errors)
+	assert.True(t, ok)
+	assert.Equal(t, 2, len(errs))
+	assert.Equal(t, "required", errs["Name"].Error())
+	assert.Equal(t, "required", errs["Street"].Error())
+
+	c.Name = "John"
+	c.Address.Street = "Main St"
+	err = ValidateStruct(&c,
+		Field(&c.Name, Required),
+		Field(&c.Address.Street, Required),
+	)
+	assert.Nil(t, err)
+}
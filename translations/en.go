@@ -0,0 +1,40 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package translations
+
+// EN is the English catalog. By default the built-in rules report their bare
+// tag as the message (e.g. "required", "email"); EN replaces those tags with
+// English sentences. Its field-comparison entries match the default messages
+// of EqField and the other field-comparison rules.
+var EN = &Catalog{
+	"required": "cannot be blank",
+	"in":       "must be a valid value",
+
+	"email":          "must be a valid email address",
+	"url":            "must be a valid URL",
+	"request_url":    "must be a valid request URL",
+	"request_uri":    "must be a valid request URI",
+	"dns":            "must be a valid DNS name",
+	"ssn":            "must be a valid social security number",
+	"semver":         "must be a valid semantic version",
+	"isbn":           "must be a valid ISBN",
+	"isbn10":         "must be a valid ISBN-10",
+	"isbn13":         "must be a valid ISBN-13",
+	"uuid":           "must be a valid UUID",
+	"uuid3":          "must be a valid UUID v3",
+	"uuid4":          "must be a valid UUID v4",
+	"uuid5":          "must be a valid UUID v5",
+	"number_integer": "must be an integer number",
+	"number_float":   "must be a floating point number",
+	"country_code2":  "must be a valid two-letter country code",
+	"country_code3":  "must be a valid three-letter country code",
+
+	"eq_field":  "must equal %s",
+	"ne_field":  "must not equal %s",
+	"gt_field":  "must be greater than %s",
+	"gte_field": "must be greater than or equal to %s",
+	"lt_field":  "must be less than %s",
+	"lte_field": "must be less than or equal to %s",
+}
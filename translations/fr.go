@@ -0,0 +1,37 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package translations
+
+// FR is the French catalog.
+var FR = &Catalog{
+	"required": "ne peut pas être vide",
+	"in":       "doit être une valeur valide",
+
+	"email":          "doit être une adresse email valide",
+	"url":            "doit être une URL valide",
+	"request_url":    "doit être une URL de requête valide",
+	"request_uri":    "doit être une URI de requête valide",
+	"dns":            "doit être un nom DNS valide",
+	"ssn":            "doit être un numéro de sécurité sociale valide",
+	"semver":         "doit être une version sémantique valide",
+	"isbn":           "doit être un ISBN valide",
+	"isbn10":         "doit être un ISBN-10 valide",
+	"isbn13":         "doit être un ISBN-13 valide",
+	"uuid":           "doit être un UUID valide",
+	"uuid3":          "doit être un UUID v3 valide",
+	"uuid4":          "doit être un UUID v4 valide",
+	"uuid5":          "doit être un UUID v5 valide",
+	"number_integer": "doit être un nombre entier",
+	"number_float":   "doit être un nombre à virgule flottante",
+	"country_code2":  "doit être un code pays à deux lettres valide",
+	"country_code3":  "doit être un code pays à trois lettres valide",
+
+	"eq_field":  "doit être égal à %s",
+	"ne_field":  "ne doit pas être égal à %s",
+	"gt_field":  "doit être supérieur à %s",
+	"gte_field": "doit être supérieur ou égal à %s",
+	"lt_field":  "doit être inférieur à %s",
+	"lte_field": "doit être inférieur ou égal à %s",
+}
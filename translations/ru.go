@@ -0,0 +1,37 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package translations
+
+// RU is the Russian catalog.
+var RU = &Catalog{
+	"required": "не может быть пустым",
+	"in":       "должно быть одним из допустимых значений",
+
+	"email":          "должен быть действительным email адресом",
+	"url":            "должен быть действительным URL",
+	"request_url":    "должен быть действительным request URL",
+	"request_uri":    "должен быть действительным request URI",
+	"dns":            "должен быть действительным DNS именем",
+	"ssn":            "должен быть действительным номером социального страхования",
+	"semver":         "должен быть действительной семантической версией",
+	"isbn":           "должен быть действительным ISBN",
+	"isbn10":         "должен быть действительным ISBN-10",
+	"isbn13":         "должен быть действительным ISBN-13",
+	"uuid":           "должен быть действительным UUID",
+	"uuid3":          "должен быть действительным UUID v3",
+	"uuid4":          "должен быть действительным UUID v4",
+	"uuid5":          "должен быть действительным UUID v5",
+	"number_integer": "должно быть целым числом",
+	"number_float":   "должно быть числом с плавающей точкой",
+	"country_code2":  "должен быть действительным двухбуквенным кодом страны",
+	"country_code3":  "должен быть действительным трёхбуквенным кодом страны",
+
+	"eq_field":  "должно быть равно %s",
+	"ne_field":  "не должно быть равно %s",
+	"gt_field":  "должно быть больше, чем %s",
+	"gte_field": "должно быть больше или равно %s",
+	"lt_field":  "должно быть меньше, чем %s",
+	"lte_field": "должно быть меньше или равно %s",
+}
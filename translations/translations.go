@@ -0,0 +1,52 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package translations provides locale catalogs that turn the message tags
+// emitted by the validation and is packages (e.g. "required", "email",
+// "isbn") into human-readable, localized strings.
+//
+// A Catalog implements validation.Translator. Register it with a validator
+// by attaching it to a context.Context via validation.WithContext and
+// passing that context to a context-aware rule:
+//
+//	ctx := validation.WithContext(context.Background(), validation.ValidationOpts{
+//		Translator: translations.RU,
+//	})
+//
+// When no translator is attached, rules fall back to their built-in
+// English messages, so existing callers see no change in behavior.
+package translations
+
+import "fmt"
+
+// Catalog is a map-based validation.Translator. Tags not present in the
+// catalog return an empty string, which validation's internal translate
+// helper treats as "no translation available" and falls back to the rule's
+// own default message, rather than surfacing the raw tag to the user.
+type Catalog map[string]string
+
+// T implements validation.Translator. Any params are formatted into the
+// translated message with fmt.Sprintf, filling its verbs such as %s, so
+// callers of NewStringRule-based custom rules can translate their own
+// tags without changing how they report errors.
+func (c Catalog) T(tag string, params ...interface{}) string {
+	msg, ok := c[tag]
+	if !ok {
+		return ""
+	}
+	if len(params) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, params...)
+}
+
+// Register adds or overrides the messages for tag across a set of
+// catalogs in one call, which is the common case for projects that define
+// their own rules with validation.NewStringRule and want every shipped
+// locale to carry a translation for them.
+func Register(tag string, byLocale map[*Catalog]string) {
+	for cat, msg := range byLocale {
+		(*cat)[tag] = msg
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package translations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalog_T(t *testing.T) {
+	assert.Equal(t, "cannot be blank", EN.T("required"))
+	assert.Equal(t, "не может быть пустым", RU.T("required"))
+	assert.Equal(t, "ne peut pas être vide", FR.T("required"))
+
+	// unknown tags return "" so validation.translate() knows to fall back to
+	// the rule's own default message instead of surfacing the raw tag
+	assert.Equal(t, "", EN.T("custom_tag"))
+}
+
+func TestCatalog_T_params(t *testing.T) {
+	c := Catalog{"gtfield": "must be greater than %s"}
+	assert.Equal(t, "must be greater than Start", c.T("gtfield", "Start"))
+}
+
+func TestRegister(t *testing.T) {
+	en := Catalog{}
+	ru := Catalog{}
+	Register("custom_tag", map[*Catalog]string{
+		&en: "must satisfy the custom rule",
+		&ru: "должно удовлетворять пользовательскому правилу",
+	})
+
+	assert.Equal(t, "must satisfy the custom rule", en.T("custom_tag"))
+	assert.Equal(t, "должно удовлетворять пользовательскому правилу", ru.T("custom_tag"))
+}
@@ -0,0 +1,56 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"errors"
+	"reflect"
+)
+
+// Indirect returns the value that the given interface or pointer references to.
+// If the value is a nil pointer or nil interface, it returns the original value
+// and true as the second return value.
+func Indirect(value interface{}) (interface{}, bool) {
+	if value == nil {
+		return nil, true
+	}
+
+	rv := reflect.ValueOf(value)
+	if (rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface) && rv.IsNil() {
+		return value, true
+	}
+	if rv.Kind() == reflect.Ptr {
+		return rv.Elem().Interface(), false
+	}
+	return value, false
+}
+
+// IsEmpty checks if a value is empty or not. A value is considered empty if it is nil,
+// or the zero value of its type (e.g. "", 0, false, an empty map/slice/array, a nil pointer
+// or function).
+func IsEmpty(value interface{}) bool {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.String, reflect.Array:
+		return v.Len() == 0
+	case reflect.Map, reflect.Slice, reflect.Chan:
+		return v.IsNil() || v.Len() == 0
+	case reflect.Ptr, reflect.Interface, reflect.Func:
+		return v.IsNil()
+	}
+	return reflect.DeepEqual(value, reflect.Zero(v.Type()).Interface())
+}
+
+// EnsureString ensures the given value is a string, returning an error otherwise. It is
+// used by rules built with NewStringRule to validate the type of the value they receive.
+func EnsureString(value interface{}) (string, error) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.String {
+		return "", errors.New("value must be a string")
+	}
+	return v.String(), nil
+}
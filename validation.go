@@ -0,0 +1,112 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package validation provides configurable and extensible rules for
+// validating data of various types.
+package validation
+
+import (
+	"context"
+	"reflect"
+)
+
+// Rule represents a validation rule.
+type Rule interface {
+	// Validate validates a value and returns an error if validation fails.
+	Validate(value interface{}) error
+}
+
+// RuleWithContext is an extended Rule that can make use of a context.Context,
+// for example to look up a Translator attached with WithContext. Validate and
+// ValidateStruct call ValidateWithContext instead of Validate for rules that
+// implement this interface.
+type RuleWithContext interface {
+	ValidateWithContext(ctx context.Context, value interface{}) error
+}
+
+// Validatable is the interface indicating the type implements its own
+// validation logic. A struct reachable from a field being validated (either
+// directly, or as an element of a pointer, slice, array or map) that
+// implements Validatable has its Validate method called automatically.
+type Validatable interface {
+	Validate() error
+}
+
+// ValidatableWithContext is like Validatable, but receives the context.Context
+// that is carrying the current call's Translator and cycle-detection state.
+// Implement this instead of Validatable when a nested type needs either.
+type ValidatableWithContext interface {
+	ValidateWithContext(ctx context.Context) error
+}
+
+// Validate validates the given value and returns a validation error, if
+// any. Rules are checked in the order they are given; the first
+// failing rule's error is returned. If value's own type (or, recursively, an
+// element reachable from it) implements Validatable or ValidatableWithContext,
+// it is validated too, once the explicit rules pass.
+func Validate(value interface{}, rules ...Rule) error {
+	return ValidateWithContext(context.Background(), value, rules...)
+}
+
+// ValidateWithContext is like Validate, but lets the caller attach a
+// ValidationOpts (e.g. a Translator) via WithContext before calling it.
+func ValidateWithContext(ctx context.Context, value interface{}, rules ...Rule) error {
+	if _, ok := visitedFromContext(ctx); !ok {
+		// A fresh visited set is scoped to this single top-level call so that
+		// concurrent validations, and separate calls on the same value, never
+		// share cycle-detection state.
+		ctx = withVisited(ctx)
+	}
+	return validateValue(ctx, value, rules...)
+}
+
+func validateValue(ctx context.Context, value interface{}, rules ...Rule) error {
+	for _, rule := range rules {
+		if cr, ok := rule.(RuleWithContext); ok {
+			if err := cr.ValidateWithContext(ctx, value); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := rule.Validate(value); err != nil {
+			return err
+		}
+	}
+
+	rv := reflect.ValueOf(value)
+	if (rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface) && rv.IsNil() {
+		return nil
+	}
+
+	// A pointer, slice or map that has already been walked earlier in this
+	// same top-level call is a cycle; stop recursing into it instead of
+	// looping forever.
+	if visit(ctx, rv) {
+		return nil
+	}
+
+	if v, ok := value.(ValidatableWithContext); ok {
+		return v.ValidateWithContext(ctx)
+	}
+	if v, ok := value.(Validatable); ok {
+		return v.Validate()
+	}
+
+	elem := reflect.Indirect(rv)
+	switch elem.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < elem.Len(); i++ {
+			if err := validateValue(ctx, elem.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		for _, key := range elem.MapKeys() {
+			if err := validateValue(ctx, elem.MapIndex(key).Interface()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}